@@ -0,0 +1,84 @@
+package awx
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Requester performs the HTTP calls a Client's services build request/response
+// handling on top of. Swapping in a fake Requester in tests exercises services
+// without a real AWX server.
+type Requester interface {
+	// Get issues a GET request to endpoint and returns the raw response; the
+	// caller reads and closes resp.Body itself.
+	Get(endpoint string, params map[string]string) (*http.Response, error)
+	// GetJSON issues a GET request to endpoint and decodes the JSON response body
+	// into result.
+	GetJSON(endpoint string, result interface{}, params map[string]string) (*http.Response, error)
+	PostJSON(endpoint string, body interface{}, result interface{}, params map[string]string) (*http.Response, error)
+	PatchJSON(endpoint string, body interface{}, result interface{}, params map[string]string) (*http.Response, error)
+	Delete(endpoint string, result interface{}, params map[string]string) (*http.Response, error)
+}
+
+// Client is the entry point for talking to an AWX server. It holds the connection
+// details every service needs (BaseURL, credentials, Requester) plus the optional
+// features this package layers on top, such as websocket-based event streaming.
+type Client struct {
+	BaseURL  string
+	Username string
+	Password string
+
+	Requester Requester
+
+	// JobTemplates and WorkflowJobTemplates expose the per-kind launch/wait APIs
+	// flow.Runner (and callers generally) use to drive a job template.
+	JobTemplates         *JobTemplateService
+	WorkflowJobTemplates *WorkflowJobTemplateService
+
+	// Events lets WaitForJob subscribe to job status changes over the websocket
+	// endpoint instead of polling, when WithEventStream is set.
+	Events *EventStreamService
+
+	useEventStream bool
+}
+
+// NewClient constructs a Client for baseURL, authenticating with username and
+// password, applying opts in order.
+func NewClient(baseURL, username, password string, opts ...ClientOption) *Client {
+	c := &Client{BaseURL: baseURL, Username: username, Password: password}
+	c.JobTemplates = &JobTemplateService{client: c}
+	c.WorkflowJobTemplates = &WorkflowJobTemplateService{client: c}
+	c.Events = &EventStreamService{client: c}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// websocketURL rewrites the client's BaseURL to the ws/wss scheme and appends path,
+// for dialing AWX's websocket endpoint.
+func (c *Client) websocketURL(path string) (string, error) {
+	u, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse base URL %q: %w", c.BaseURL, err)
+	}
+	if u.Scheme == "https" {
+		u.Scheme = "wss"
+	} else {
+		u.Scheme = "ws"
+	}
+	u.Path = strings.TrimRight(u.Path, "/") + path
+	return u.String(), nil
+}
+
+// websocketHeader returns the Basic auth header used to authenticate the websocket
+// handshake, the same credentials every other Client request uses.
+func (c *Client) websocketHeader() http.Header {
+	header := http.Header{}
+	token := base64.StdEncoding.EncodeToString([]byte(c.Username + ":" + c.Password))
+	header.Set("Authorization", "Basic "+token)
+	return header
+}
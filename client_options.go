@@ -0,0 +1,13 @@
+package awx
+
+// ClientOption configures optional Client behavior at construction time.
+type ClientOption func(*Client)
+
+// WithEventStream enables the websocket event transport: WaitForJob subscribes to
+// `/websocket/` for status updates instead of polling, falling back to HTTP polling
+// if the connection can't be established or drops mid-wait.
+func WithEventStream() ClientOption {
+	return func(c *Client) {
+		c.useEventStream = true
+	}
+}
@@ -0,0 +1,36 @@
+// Package errors defines the typed errors AWX API calls can fail with, so callers
+// can branch on failure kind with errors.Is/errors.As instead of string-matching.
+package errors
+
+import "fmt"
+
+// ErrNotFound is wrapped by errors returned when a requested object doesn't exist.
+var ErrNotFound = fmt.Errorf("not found")
+
+// ErrConflict is wrapped by errors returned when AWX rejects a request because it
+// conflicts with the object's current state (HTTP 409).
+var ErrConflict = fmt.Errorf("conflict")
+
+// ValidationError reports that a single field in a request was missing or invalid.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Reason)
+}
+
+// APIError wraps a non-2xx response from the AWX API.
+type APIError struct {
+	Status    int
+	Body      string
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("awx: request %s failed with status %d: %s", e.RequestID, e.Status, e.Body)
+	}
+	return fmt.Sprintf("awx: request failed with status %d: %s", e.Status, e.Body)
+}
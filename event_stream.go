@@ -0,0 +1,233 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// EventStreamService subscribes to AWX's `/websocket/` endpoint for real-time job
+// and job_event updates, used by WaitForJob as an alternative to HTTP polling.
+type EventStreamService struct {
+	client *Client
+}
+
+// Filter narrows a websocket subscription down to specific jobs and/or workflow jobs.
+// An empty Filter subscribes to every job and job_event on the server.
+type Filter struct {
+	JobIDs         []int
+	WorkflowJobIDs []int
+}
+
+// Event is the union of messages delivered on an EventStream subscription.
+type Event interface {
+	isEvent()
+}
+
+// StatusChange reports a job or workflow job transitioning to a new status.
+type StatusChange struct {
+	JobID  int       `json:"unified_job_id"`
+	Status JobStatus `json:"status"`
+}
+
+func (StatusChange) isEvent() {}
+
+// PlaybookEvent is a single row of a job's `/job_events/`, also delivered live over the
+// `job_events` websocket group.
+type PlaybookEvent struct {
+	JobID     int                    `json:"job_id"`
+	Counter   int                    `json:"counter"`
+	Event     string                 `json:"event"`
+	EventData map[string]interface{} `json:"event_data"`
+	Host      string                 `json:"host_name"`
+	Task      string                 `json:"task"`
+	Play      string                 `json:"play"`
+	Stdout    string                 `json:"stdout"`
+}
+
+func (PlaybookEvent) isEvent() {}
+
+// SummaryEvent reports the final host/task counts for a finished job.
+type SummaryEvent struct {
+	JobID   int            `json:"unified_job_id"`
+	Status  JobStatus      `json:"status"`
+	Summary map[string]int `json:"summary"`
+}
+
+func (SummaryEvent) isEvent() {}
+
+// wsEnvelope is the `{"group": ..., ...payload}` shape AWX sends on its websocket.
+type wsEnvelope struct {
+	Group string `json:"group"`
+	Type  string `json:"type"`
+}
+
+// Subscribe dials AWX's websocket endpoint, joins the `jobs` and `job_events` groups
+// restricted to filter, and streams decoded events on the returned channel until ctx
+// is canceled or the connection drops. The channel is closed once the stream ends.
+func (s *EventStreamService) Subscribe(ctx context.Context, filter Filter) (<-chan Event, error) {
+	conn, _, err := s.client.dialWebsocket(ctx, "/websocket/")
+	if err != nil {
+		return nil, fmt.Errorf("dial websocket: %w", err)
+	}
+
+	if err := conn.WriteJSON(map[string]interface{}{
+		"groups": map[string]interface{}{
+			"jobs":       filter.jobGroupArgs(),
+			"job_events": filter.jobGroupArgs(),
+		},
+	}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("subscribe to groups: %w", err)
+	}
+
+	events := make(chan Event)
+	stopped := make(chan struct{})
+	var closeOnce sync.Once
+	closeConn := func() { closeOnce.Do(func() { conn.Close() }) }
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			closeConn()
+		case <-stopped:
+			// The read loop below already closed the connection on its own (EOF,
+			// reset, etc.); nothing left to do, so exit instead of leaking this
+			// goroutine until ctx is eventually canceled.
+		}
+	}()
+
+	go func() {
+		defer close(stopped)
+		defer close(events)
+		defer closeConn()
+		for {
+			_, raw, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			event, ok := decodeEvent(raw)
+			if !ok {
+				continue
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (f Filter) jobGroupArgs() []string {
+	args := make([]string, 0, len(f.JobIDs)+len(f.WorkflowJobIDs))
+	for _, id := range f.JobIDs {
+		args = append(args, strconv.Itoa(id))
+	}
+	for _, id := range f.WorkflowJobIDs {
+		args = append(args, strconv.Itoa(id))
+	}
+	return args
+}
+
+func decodeEvent(raw []byte) (Event, bool) {
+	var envelope wsEnvelope
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, false
+	}
+
+	switch {
+	case envelope.Group == "jobs" && envelope.Type == "":
+		var ev StatusChange
+		if json.Unmarshal(raw, &ev) != nil {
+			return nil, false
+		}
+		return ev, true
+	case envelope.Type == "summary":
+		var ev SummaryEvent
+		if json.Unmarshal(raw, &ev) != nil {
+			return nil, false
+		}
+		return ev, true
+	default:
+		var ev PlaybookEvent
+		if json.Unmarshal(raw, &ev) != nil {
+			return nil, false
+		}
+		return ev, true
+	}
+}
+
+// waitForJobViaEvents waits for jobID to reach a terminal status using the client's
+// EventStream, falling back to poll (HTTP) if the subscription can't be established
+// or the connection drops before the job finishes.
+func waitForJobViaEvents(ctx context.Context, client *Client, jobID int, opts WaitOptions, poll jobFetcher) (*Job, error) {
+	opts = opts.withDefaults()
+
+	if opts.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, opts.Timeout)
+		defer cancelTimeout()
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := client.Events.Subscribe(streamCtx, Filter{JobIDs: []int{jobID}, WorkflowJobIDs: []int{jobID}})
+	if err != nil {
+		return waitForJob(ctx, jobID, opts, poll)
+	}
+
+	tries := 0
+	for {
+		select {
+		case <-ctx.Done():
+			job, _ := poll(jobID)
+			return job, ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				// Connection dropped before a terminal status arrived; fall back to polling.
+				return waitForJob(ctx, jobID, opts, poll)
+			}
+			sc, ok := ev.(StatusChange)
+			if !ok || sc.JobID != jobID {
+				continue
+			}
+			job, err := poll(jobID)
+			tries++
+			if err != nil {
+				return job, err
+			}
+			if opts.OnStatus != nil {
+				opts.OnStatus(job)
+			}
+			if isTerminal(job) {
+				return job, nil
+			}
+			if opts.MaxTries != -1 && tries >= opts.MaxTries {
+				return job, fmt.Errorf("the maximum number %v of checking job status has been reached", opts.MaxTries)
+			}
+		}
+	}
+}
+
+// dialWebsocket opens a websocket connection to path on the same host/credentials as
+// the client's regular HTTP requests.
+func (c *Client) dialWebsocket(ctx context.Context, path string) (*websocket.Conn, interface{}, error) {
+	wsURL, err := c.websocketURL(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	dialer := websocket.Dialer{}
+	conn, resp, err := dialer.DialContext(ctx, wsURL, c.websocketHeader())
+	if err != nil {
+		return nil, resp, err
+	}
+	return conn, resp, nil
+}
@@ -0,0 +1,140 @@
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+		want Event
+	}{
+		{
+			name: "status change",
+			raw:  `{"group":"jobs","unified_job_id":42,"status":"running"}`,
+			want: StatusChange{JobID: 42, Status: RUNNING},
+		},
+		{
+			name: "summary",
+			raw:  `{"group":"job_events","type":"summary","unified_job_id":42,"status":"successful","summary":{"ok":3}}`,
+			want: SummaryEvent{JobID: 42, Status: SUCCESSFUL, Summary: map[string]int{"ok": 3}},
+		},
+		{
+			name: "playbook event",
+			raw:  `{"group":"job_events","job_id":42,"counter":7,"event":"runner_on_ok","host_name":"web1"}`,
+			want: PlaybookEvent{JobID: 42, Counter: 7, Event: "runner_on_ok", Host: "web1"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := decodeEvent([]byte(tc.raw))
+			if !ok {
+				t.Fatalf("decodeEvent() ok = false, want true")
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("decodeEvent() = %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodeEventInvalidJSON(t *testing.T) {
+	if _, ok := decodeEvent([]byte("not json")); ok {
+		t.Fatal("decodeEvent() ok = true for invalid JSON, want false")
+	}
+}
+
+// wsTestServer starts an httptest.Server that upgrades every request to a
+// websocket and hands the connection to handle, which runs for the life of the
+// connection.
+func wsTestServer(t *testing.T, handle func(*websocket.Conn)) *httptest.Server {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handle(conn)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestWaitForJobViaEventsUsesStatusChange drives the happy path: Subscribe
+// succeeds, a StatusChange arrives over the websocket, and waitForJobViaEvents
+// confirms the job is terminal by polling rather than trusting the event alone.
+func TestWaitForJobViaEventsUsesStatusChange(t *testing.T) {
+	server := wsTestServer(t, func(conn *websocket.Conn) {
+		var subscribe map[string]interface{}
+		if err := conn.ReadJSON(&subscribe); err != nil {
+			return
+		}
+		if err := conn.WriteJSON(map[string]interface{}{
+			"group": "jobs", "unified_job_id": 7, "status": "successful",
+		}); err != nil {
+			return
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	})
+
+	client := &Client{BaseURL: server.URL}
+	client.Events = &EventStreamService{client: client}
+
+	polls := 0
+	poll := func(int) (*Job, error) {
+		polls++
+		return &Job{Status: string(SUCCESSFUL)}, nil
+	}
+
+	job, err := waitForJobViaEvents(context.Background(), client, 7, WaitOptions{}, poll)
+	if err != nil {
+		t.Fatalf("waitForJobViaEvents() error = %v", err)
+	}
+	if job.Status != string(SUCCESSFUL) {
+		t.Fatalf("job.Status = %q, want %q", job.Status, SUCCESSFUL)
+	}
+	if polls != 1 {
+		t.Fatalf("poll called %d times, want 1 (status change should trigger a confirming GET, not be trusted alone)", polls)
+	}
+}
+
+// TestWaitForJobViaEventsFallsBackWhenSubscribeFails covers the other half of the
+// contract: if the websocket can't be established at all, waitForJobViaEvents
+// falls back to HTTP polling instead of failing outright.
+func TestWaitForJobViaEventsFallsBackWhenSubscribeFails(t *testing.T) {
+	client := &Client{BaseURL: "http://127.0.0.1:0"}
+	client.Events = &EventStreamService{client: client}
+
+	polls := 0
+	poll := func(int) (*Job, error) {
+		polls++
+		return &Job{Status: string(SUCCESSFUL)}, nil
+	}
+
+	opts := WaitOptions{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+	job, err := waitForJobViaEvents(context.Background(), client, 7, opts, poll)
+	if err != nil {
+		t.Fatalf("waitForJobViaEvents() error = %v", err)
+	}
+	if job.Status != string(SUCCESSFUL) {
+		t.Fatalf("job.Status = %q, want %q", job.Status, SUCCESSFUL)
+	}
+	if polls == 0 {
+		t.Fatal("poll never called, want fallback to HTTP polling when Subscribe fails")
+	}
+}
@@ -0,0 +1,125 @@
+// Package flow lets callers describe and run a DAG of AWX job template launches
+// client-side, without having to precreate an AWX Workflow Template for every
+// variation of a pipeline.
+package flow
+
+import (
+	"fmt"
+
+	"github.com/xu001186/awx-go"
+)
+
+// Condition controls whether an edge's child node runs, based on how its parent
+// finished.
+type Condition string
+
+const (
+	// OnSuccess runs the child only if the parent job completed successfully.
+	OnSuccess Condition = "on_success"
+	// OnFailure runs the child only if the parent job failed, errored or was canceled.
+	OnFailure Condition = "on_failure"
+	// OnAlways runs the child regardless of how the parent finished.
+	OnAlways Condition = "on_always"
+)
+
+// ArtifactForward copies a key out of a parent job's Artifacts into a child node's
+// extra_vars before the child is launched.
+type ArtifactForward struct {
+	From string
+	To   string
+}
+
+// Node is a single job template (or workflow job template) launch in a DAG.
+type Node struct {
+	// ID identifies this node within its DAG; it is not sent to AWX.
+	ID string
+	// TemplateID is the JobTemplate or WorkflowJobTemplate ID to launch.
+	TemplateID int
+	// IsWorkflow selects WorkflowJobTemplateService over JobTemplateService.
+	IsWorkflow bool
+	// Options are passed to LaunchWithOptions as-is, aside from ExtraVars, which
+	// Forwards may add to.
+	Options awx.LaunchOptions
+	// Forwards copies values out of each dependency's Job.Artifacts into Options.ExtraVars.
+	Forwards []ArtifactForward
+	// dependsOn maps a parent node ID to the condition under which this node runs.
+	dependsOn map[string]Condition
+}
+
+// DAG is a set of Nodes and the dependencies between them.
+type DAG struct {
+	Nodes map[string]*Node
+}
+
+// NewDAG returns an empty DAG.
+func NewDAG() *DAG {
+	return &DAG{Nodes: make(map[string]*Node)}
+}
+
+// AddNode registers n in the DAG. It panics if n.ID is already taken, since that
+// points at a builder bug rather than a runtime condition callers should handle.
+func (d *DAG) AddNode(n *Node) *DAG {
+	if _, exists := d.Nodes[n.ID]; exists {
+		panic(fmt.Sprintf("flow: duplicate node id %q", n.ID))
+	}
+	d.Nodes[n.ID] = n
+	return d
+}
+
+// AddEdge declares that child depends on parent, running only when cond is met.
+func (d *DAG) AddEdge(parent, child string, cond Condition) *DAG {
+	node, ok := d.Nodes[child]
+	if !ok {
+		panic(fmt.Sprintf("flow: unknown child node %q", child))
+	}
+	if _, ok := d.Nodes[parent]; !ok {
+		panic(fmt.Sprintf("flow: unknown parent node %q", parent))
+	}
+	if node.dependsOn == nil {
+		node.dependsOn = make(map[string]Condition)
+	}
+	node.dependsOn[parent] = cond
+	return d
+}
+
+// Validate checks that every dependency resolves to a node in the DAG and that the
+// graph contains no cycles.
+func (d *DAG) Validate() error {
+	for id, n := range d.Nodes {
+		for parent := range n.dependsOn {
+			if _, ok := d.Nodes[parent]; !ok {
+				return fmt.Errorf("flow: node %q depends on unknown node %q", id, parent)
+			}
+		}
+	}
+
+	const (
+		unvisited = 0
+		visiting  = 1
+		visited   = 2
+	)
+	state := make(map[string]int, len(d.Nodes))
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch state[id] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("flow: dependency cycle detected at node %q", id)
+		}
+		state[id] = visiting
+		for parent := range d.Nodes[id].dependsOn {
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		state[id] = visited
+		return nil
+	}
+	for id := range d.Nodes {
+		if err := visit(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package flow
+
+import "testing"
+
+func TestDAGValidateDetectsCycle(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "b"})
+	d.AddNode(&Node{ID: "c"})
+	d.AddEdge("a", "b", OnSuccess)
+	d.AddEdge("b", "c", OnSuccess)
+	d.AddEdge("c", "a", OnSuccess)
+
+	if err := d.Validate(); err == nil {
+		t.Fatal("Validate() = nil, want cycle error")
+	}
+}
+
+func TestDAGValidateAcyclic(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "b"})
+	d.AddNode(&Node{ID: "c"})
+	d.AddEdge("a", "b", OnSuccess)
+	d.AddEdge("a", "c", OnFailure)
+
+	if err := d.Validate(); err != nil {
+		t.Fatalf("Validate() = %v, want nil", err)
+	}
+}
+
+func TestDAGValidateUnknownParent(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "b", dependsOn: map[string]Condition{"ghost": OnSuccess}})
+
+	if err := d.Validate(); err == nil {
+		t.Fatal("Validate() = nil, want unknown-dependency error")
+	}
+}
+
+func TestAddNodeDuplicatePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("AddNode() did not panic on duplicate id")
+		}
+	}()
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "a"})
+}
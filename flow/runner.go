@@ -0,0 +1,303 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/xu001186/awx-go"
+)
+
+// NodeStatus is the lifecycle state of a single Node within a Run.
+type NodeStatus string
+
+const (
+	StatusPending   NodeStatus = "pending"
+	StatusRunning   NodeStatus = "running"
+	StatusSkipped   NodeStatus = "skipped"
+	StatusSucceeded NodeStatus = "succeeded"
+	StatusFailed    NodeStatus = "failed"
+)
+
+// NodeResult records how one node's launch/wait turned out.
+type NodeResult struct {
+	Status NodeStatus
+	// IsWorkflow mirrors the launching Node's IsWorkflow, so Cancel can pick the
+	// right AWX cancel endpoint without needing the DAG back.
+	IsWorkflow bool
+	JobID      int
+	Job        *awx.Job
+	Err        string
+}
+
+func (r NodeResult) terminal() bool {
+	switch r.Status {
+	case StatusSucceeded, StatusFailed, StatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r NodeResult) succeeded() bool { return r.Status == StatusSucceeded }
+func (r NodeResult) failed() bool    { return r.Status == StatusFailed }
+
+// RunState is a persistable snapshot of a Runner's progress. Passing a saved
+// RunState to NewRunner resumes a paused or interrupted run: nodes that already
+// succeeded are not relaunched.
+type RunState struct {
+	Results map[string]NodeResult
+}
+
+// Runner executes a DAG concurrently, launching each node once every node it
+// depends on has reached a terminal status and its edge condition is satisfied.
+type Runner struct {
+	client *awx.Client
+	dag    *DAG
+
+	mu    sync.Mutex
+	state RunState
+
+	cancel context.CancelFunc
+}
+
+// NewRunner prepares a Runner for dag. If state is non-nil, it resumes a
+// previously paused or interrupted run instead of starting fresh.
+func NewRunner(client *awx.Client, dag *DAG, state *RunState) (*Runner, error) {
+	if err := dag.Validate(); err != nil {
+		return nil, err
+	}
+	r := &Runner{client: client, dag: dag, state: RunState{Results: make(map[string]NodeResult)}}
+	if state != nil {
+		for id, res := range state.Results {
+			r.state.Results[id] = res
+		}
+	}
+	return r, nil
+}
+
+// State returns a snapshot of the Runner's current progress, safe to persist and
+// later pass back into NewRunner to resume.
+func (r *Runner) State() RunState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	snapshot := RunState{Results: make(map[string]NodeResult, len(r.state.Results))}
+	for id, res := range r.state.Results {
+		snapshot.Results[id] = res
+	}
+	return snapshot
+}
+
+// Cancel stops launching new nodes and best-effort cancels any AWX jobs this Runner
+// has already started and is still waiting on.
+func (r *Runner) Cancel(ctx context.Context) error {
+	r.mu.Lock()
+	cancel := r.cancel
+	running := make([]NodeResult, 0)
+	for _, res := range r.state.Results {
+		if res.Status == StatusRunning && res.JobID != 0 {
+			running = append(running, res)
+		}
+	}
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	var firstErr error
+	for _, res := range running {
+		resp, err := r.client.Requester.PostJSON(cancelEndpoint(res), nil, nil, nil)
+		if err == nil {
+			err = awx.CheckResponse(resp)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// cancelEndpoint picks the AWX cancel endpoint matching res's job kind: workflow
+// jobs and regular jobs are canceled through different URLs.
+func cancelEndpoint(res NodeResult) string {
+	if res.IsWorkflow {
+		return fmt.Sprintf("/api/v2/workflow_jobs/%d/cancel/", res.JobID)
+	}
+	return fmt.Sprintf("/api/v2/jobs/%d/cancel/", res.JobID)
+}
+
+// Run executes the DAG to completion (or until ctx is canceled), returning the
+// final RunState. A non-nil error is the first node failure encountered; other
+// nodes may still have run and their results are in the returned RunState.
+func (r *Runner) Run(ctx context.Context) (RunState, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	r.mu.Lock()
+	r.cancel = cancel
+	r.mu.Unlock()
+	defer cancel()
+
+	done := make(map[string]chan struct{}, len(r.dag.Nodes))
+	for id := range r.dag.Nodes {
+		done[id] = make(chan struct{})
+	}
+
+	errs := make(chan error, len(r.dag.Nodes))
+	var wg sync.WaitGroup
+	for id, node := range r.dag.Nodes {
+		wg.Add(1)
+		go func(id string, node *Node) {
+			defer wg.Done()
+			defer close(done[id])
+			errs <- r.runNode(ctx, node, done)
+		}(id, node)
+	}
+	wg.Wait()
+	close(errs)
+
+	var firstErr error
+	for err := range errs {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return r.State(), firstErr
+}
+
+// runNode waits for node's dependencies, decides whether it should run given their
+// outcomes, and if so launches it and waits for it to finish.
+func (r *Runner) runNode(ctx context.Context, node *Node, done map[string]chan struct{}) error {
+	for parent := range node.dependsOn {
+		select {
+		case <-done[parent]:
+		case <-ctx.Done():
+			r.setResult(node.ID, NodeResult{Status: StatusSkipped, Err: ctx.Err().Error()})
+			return nil
+		}
+	}
+
+	if existing, ok := r.getResult(node.ID); ok && existing.succeeded() {
+		return nil // already ran in a prior, resumed Run.
+	}
+
+	if !r.shouldRun(node) {
+		r.setResult(node.ID, NodeResult{Status: StatusSkipped})
+		return nil
+	}
+
+	extraVars, err := r.forwardedExtraVars(node)
+	if err != nil {
+		r.setResult(node.ID, NodeResult{Status: StatusFailed, Err: err.Error()})
+		return err
+	}
+	opts := node.Options
+	opts.ExtraVars = extraVars
+
+	r.setResult(node.ID, NodeResult{Status: StatusRunning, IsWorkflow: node.IsWorkflow})
+	job, err := r.launch(ctx, node, opts)
+	if err != nil {
+		r.setResult(node.ID, NodeResult{Status: StatusFailed, IsWorkflow: node.IsWorkflow, Err: err.Error()})
+		return fmt.Errorf("node %q: %w", node.ID, err)
+	}
+
+	status := StatusSucceeded
+	if job.Status != string(awx.SUCCESSFUL) {
+		status = StatusFailed
+	}
+	r.setResult(node.ID, NodeResult{Status: status, IsWorkflow: node.IsWorkflow, JobID: jobID(job), Job: job})
+	if status == StatusFailed {
+		return fmt.Errorf("node %q: job %d finished with status %s", node.ID, jobID(job), job.Status)
+	}
+	return nil
+}
+
+// shouldRun evaluates node's edge conditions against its parents' results. A node
+// with no parents always runs.
+func (r *Runner) shouldRun(node *Node) bool {
+	if len(node.dependsOn) == 0 {
+		return true
+	}
+	for parent, cond := range node.dependsOn {
+		res, _ := r.getResult(parent)
+		switch cond {
+		case OnSuccess:
+			if !res.succeeded() {
+				return false
+			}
+		case OnFailure:
+			if !res.failed() {
+				return false
+			}
+		case OnAlways:
+			if !res.terminal() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// forwardedExtraVars merges node.Options.ExtraVars with values copied from each
+// dependency's job artifacts per node.Forwards.
+func (r *Runner) forwardedExtraVars(node *Node) (map[string]interface{}, error) {
+	merged := make(map[string]interface{}, len(node.Options.ExtraVars)+len(node.Forwards))
+	for k, v := range node.Options.ExtraVars {
+		merged[k] = v
+	}
+	for _, fwd := range node.Forwards {
+		var source *NodeResult
+		for parent := range node.dependsOn {
+			res, ok := r.getResult(parent)
+			if ok && res.Job != nil {
+				if _, has := res.Job.Artifacts[fwd.From]; has {
+					source = &res
+					break
+				}
+			}
+		}
+		if source == nil {
+			return nil, fmt.Errorf("artifact %q not found on any dependency of node %q", fwd.From, node.ID)
+		}
+		merged[fwd.To] = source.Job.Artifacts[fwd.From]
+	}
+	return merged, nil
+}
+
+// launch starts node against the appropriate AWX service and waits for it to finish.
+func (r *Runner) launch(ctx context.Context, node *Node, opts awx.LaunchOptions) (*awx.Job, error) {
+	if node.IsWorkflow {
+		jobID, err := r.client.WorkflowJobTemplates.LaunchWithOptions(ctx, node.TemplateID, opts)
+		if err != nil {
+			return nil, err
+		}
+		r.setResult(node.ID, NodeResult{Status: StatusRunning, IsWorkflow: true, JobID: jobID})
+		return r.client.WorkflowJobTemplates.WaitForJob(ctx, jobID, awx.WaitOptions{})
+	}
+
+	jobID, err := r.client.JobTemplates.LaunchWithOptions(ctx, node.TemplateID, opts)
+	if err != nil {
+		return nil, err
+	}
+	r.setResult(node.ID, NodeResult{Status: StatusRunning, JobID: jobID})
+	return r.client.JobTemplates.WaitForJob(ctx, jobID, awx.WaitOptions{})
+}
+
+func jobID(job *awx.Job) int {
+	if job == nil {
+		return 0
+	}
+	return job.ID
+}
+
+func (r *Runner) getResult(id string) (NodeResult, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res, ok := r.state.Results[id]
+	return res, ok
+}
+
+func (r *Runner) setResult(id string, res NodeResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.state.Results[id] = res
+}
@@ -0,0 +1,222 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	awx "github.com/xu001186/awx-go"
+)
+
+func newTestRunner(t *testing.T, dag *DAG) *Runner {
+	t.Helper()
+	r, err := NewRunner(nil, dag, nil)
+	if err != nil {
+		t.Fatalf("NewRunner() error = %v", err)
+	}
+	return r
+}
+
+func TestShouldRunNoDependencies(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	r := newTestRunner(t, d)
+
+	if !r.shouldRun(d.Nodes["a"]) {
+		t.Fatal("shouldRun() = false for a node with no dependencies, want true")
+	}
+}
+
+func TestShouldRunOnSuccess(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "b"})
+	d.AddEdge("a", "b", OnSuccess)
+	r := newTestRunner(t, d)
+
+	r.setResult("a", NodeResult{Status: StatusFailed})
+	if r.shouldRun(d.Nodes["b"]) {
+		t.Fatal("shouldRun() = true for OnSuccess child of a failed parent, want false")
+	}
+
+	r.setResult("a", NodeResult{Status: StatusSucceeded})
+	if !r.shouldRun(d.Nodes["b"]) {
+		t.Fatal("shouldRun() = false for OnSuccess child of a succeeded parent, want true")
+	}
+}
+
+func TestShouldRunOnFailure(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "b"})
+	d.AddEdge("a", "b", OnFailure)
+	r := newTestRunner(t, d)
+
+	r.setResult("a", NodeResult{Status: StatusSucceeded})
+	if r.shouldRun(d.Nodes["b"]) {
+		t.Fatal("shouldRun() = true for OnFailure child of a succeeded parent, want false")
+	}
+
+	r.setResult("a", NodeResult{Status: StatusFailed})
+	if !r.shouldRun(d.Nodes["b"]) {
+		t.Fatal("shouldRun() = false for OnFailure child of a failed parent, want true")
+	}
+}
+
+func TestShouldRunOnAlways(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "b"})
+	d.AddEdge("a", "b", OnAlways)
+	r := newTestRunner(t, d)
+
+	if r.shouldRun(d.Nodes["b"]) {
+		t.Fatal("shouldRun() = true before parent reached a terminal status, want false")
+	}
+
+	r.setResult("a", NodeResult{Status: StatusSkipped})
+	if !r.shouldRun(d.Nodes["b"]) {
+		t.Fatal("shouldRun() = false for OnAlways child of a skipped parent, want true")
+	}
+}
+
+func TestForwardedExtraVars(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{
+		ID:       "b",
+		Options:  awx.LaunchOptions{ExtraVars: map[string]interface{}{"existing": "kept"}},
+		Forwards: []ArtifactForward{{From: "version", To: "release_version"}},
+	})
+	d.AddEdge("a", "b", OnSuccess)
+	r := newTestRunner(t, d)
+
+	r.setResult("a", NodeResult{
+		Status: StatusSucceeded,
+		Job:    &awx.Job{Artifacts: map[string]interface{}{"version": "1.2.3"}},
+	})
+
+	merged, err := r.forwardedExtraVars(d.Nodes["b"])
+	if err != nil {
+		t.Fatalf("forwardedExtraVars() error = %v", err)
+	}
+	if merged["existing"] != "kept" {
+		t.Errorf("merged[%q] = %v, want %q", "existing", merged["existing"], "kept")
+	}
+	if merged["release_version"] != "1.2.3" {
+		t.Errorf("merged[%q] = %v, want %q", "release_version", merged["release_version"], "1.2.3")
+	}
+}
+
+func TestForwardedExtraVarsMissingArtifact(t *testing.T) {
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a"})
+	d.AddNode(&Node{ID: "b", Forwards: []ArtifactForward{{From: "missing", To: "x"}}})
+	d.AddEdge("a", "b", OnSuccess)
+	r := newTestRunner(t, d)
+
+	r.setResult("a", NodeResult{Status: StatusSucceeded, Job: &awx.Job{}})
+
+	if _, err := r.forwardedExtraVars(d.Nodes["b"]); err == nil {
+		t.Fatal("forwardedExtraVars() error = nil, want error for missing artifact")
+	}
+}
+
+// fakeJobTemplateRequester is a minimal awx.Requester serving a single job
+// template's launch endpoint and the resulting job's status, so Runner.Run
+// exercises the exact launch-then-wait path launch() sits on top of.
+type fakeJobTemplateRequester struct {
+	launchEndpoint string
+	jobEndpoint    string
+	jobID          int
+}
+
+func (f *fakeJobTemplateRequester) reply(status int, body string) (*http.Response, error) {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func (f *fakeJobTemplateRequester) Get(endpoint string, params map[string]string) (*http.Response, error) {
+	return f.reply(http.StatusOK, "")
+}
+
+func (f *fakeJobTemplateRequester) GetJSON(endpoint string, result interface{}, params map[string]string) (*http.Response, error) {
+	var body string
+	switch endpoint {
+	case f.launchEndpoint:
+		body = "{}" // no ask_*_on_launch flags set, so an empty LaunchOptions validates cleanly.
+	case f.jobEndpoint:
+		body = fmt.Sprintf(`{"id":%d,"status":"successful"}`, f.jobID)
+	default:
+		return nil, fmt.Errorf("fakeJobTemplateRequester: unexpected GET %s", endpoint)
+	}
+	if err := json.Unmarshal([]byte(body), result); err != nil {
+		return nil, err
+	}
+	return f.reply(http.StatusOK, body)
+}
+
+func (f *fakeJobTemplateRequester) PostJSON(endpoint string, _ interface{}, result interface{}, _ map[string]string) (*http.Response, error) {
+	if endpoint != f.launchEndpoint {
+		return nil, fmt.Errorf("fakeJobTemplateRequester: unexpected POST %s", endpoint)
+	}
+	body := fmt.Sprintf(`{"job":%d}`, f.jobID)
+	if err := json.Unmarshal([]byte(body), result); err != nil {
+		return nil, err
+	}
+	return f.reply(http.StatusOK, body)
+}
+
+func (f *fakeJobTemplateRequester) PatchJSON(string, interface{}, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+
+func (f *fakeJobTemplateRequester) Delete(string, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+
+// TestRunnerRunLaunchesAndWaitsForJobTemplateNode drives Run end to end for a
+// single non-workflow node against a real *awx.Client, the gap that let
+// launch()'s reference to client.JobTemplates/client.WorkflowJobTemplates ship
+// without either field existing on Client.
+func TestRunnerRunLaunchesAndWaitsForJobTemplateNode(t *testing.T) {
+	client := awx.NewClient("http://awx.example", "user", "pass")
+	client.Requester = &fakeJobTemplateRequester{
+		launchEndpoint: "/api/v2/job_templates/1/launch/",
+		jobEndpoint:    "/api/v2/jobs/42",
+		jobID:          42,
+	}
+
+	d := NewDAG()
+	d.AddNode(&Node{ID: "a", TemplateID: 1})
+
+	r, err := NewRunner(client, d, nil)
+	if err != nil {
+		t.Fatalf("NewRunner() error = %v", err)
+	}
+
+	state, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	res := state.Results["a"]
+	if res.Status != StatusSucceeded {
+		t.Fatalf("node a status = %v, want %v", res.Status, StatusSucceeded)
+	}
+	if res.JobID != 42 {
+		t.Fatalf("node a JobID = %d, want 42", res.JobID)
+	}
+}
+
+func TestCancelEndpointPicksJobKind(t *testing.T) {
+	if got, want := cancelEndpoint(NodeResult{JobID: 7}), "/api/v2/jobs/7/cancel/"; got != want {
+		t.Errorf("cancelEndpoint(job) = %q, want %q", got, want)
+	}
+	if got, want := cancelEndpoint(NodeResult{JobID: 7, IsWorkflow: true}), "/api/v2/workflow_jobs/7/cancel/"; got != want {
+		t.Errorf("cancelEndpoint(workflow job) = %q, want %q", got, want)
+	}
+}
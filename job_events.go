@@ -0,0 +1,125 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// JobEventService lists the structured Ansible events recorded for a job.
+type JobEventService struct {
+	client *Client
+}
+
+// listJobEventsResponse represents the `/api/v2/jobs/{id}/job_events/` response.
+type listJobEventsResponse struct {
+	Pagination
+	Results []PlaybookEvent `json:"results"`
+}
+
+// Iterator walks a paginated AWX list endpoint one item at a time, transparently
+// following `next` links as the buffered page runs out.
+type Iterator[T any] struct {
+	fetch   func(url string) ([]T, string, error)
+	nextURL string
+	buf     []T
+	done    bool
+	err     error
+}
+
+// Next advances the iterator, fetching another page if the buffered one is
+// exhausted. It returns false once the endpoint has no more results, ctx is
+// canceled, or a request fails; check Err to tell those cases apart.
+func (it *Iterator[T]) Next(ctx context.Context) (T, bool) {
+	var zero T
+	for len(it.buf) == 0 {
+		if it.done {
+			return zero, false
+		}
+		select {
+		case <-ctx.Done():
+			it.err = ctx.Err()
+			it.done = true
+			return zero, false
+		default:
+		}
+
+		items, next, err := it.fetch(it.nextURL)
+		if err != nil {
+			it.err = err
+			it.done = true
+			return zero, false
+		}
+		it.buf = items
+		it.nextURL = next
+		if next == "" {
+			it.done = true
+		}
+	}
+
+	item := it.buf[0]
+	it.buf = it.buf[1:]
+	return item, true
+}
+
+// Err returns the error that stopped iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// List walks jobID's job_events, decoding each row's event, event_data, host, task,
+// play, stdout and counter fields.
+func (s *JobEventService) List(jobID int, params map[string]string) *Iterator[PlaybookEvent] {
+	endpoint := fmt.Sprintf("/api/v2/jobs/%d/job_events/", jobID)
+	return &Iterator[PlaybookEvent]{
+		fetch: func(url string) ([]PlaybookEvent, string, error) {
+			// params only apply to the first request; every later page is fetched
+			// from AWX's `next` link exactly as AWX gave it to us, with no params
+			// added, so the `page=` cursor embedded in that link is kept as is.
+			reqParams := params
+			if url == "" {
+				url = endpoint
+			} else {
+				reqParams = nil
+			}
+
+			result := new(listJobEventsResponse)
+			resp, err := s.client.Requester.GetJSON(url, result, reqParams)
+			if err != nil {
+				return nil, "", err
+			}
+			if err := CheckResponse(resp); err != nil {
+				return nil, "", err
+			}
+			return result.Results, result.Next, nil
+		},
+	}
+}
+
+// PrintPlaybookProgress consumes jobID's job_events and renders Ansible-style
+// per-play/per-task/per-host status to w, suitable for CI logs.
+func PrintPlaybookProgress(ctx context.Context, w io.Writer, events *JobEventService, jobID int) error {
+	it := events.List(jobID, nil)
+	for {
+		ev, ok := it.Next(ctx)
+		if !ok {
+			break
+		}
+		switch ev.Event {
+		case "playbook_on_play_start":
+			fmt.Fprintf(w, "PLAY [%s] %s\n", ev.Play, strings.Repeat("*", 10))
+		case "playbook_on_task_start":
+			fmt.Fprintf(w, "TASK [%s] %s\n", ev.Task, strings.Repeat("*", 10))
+		case "runner_on_ok":
+			fmt.Fprintf(w, "ok: [%s]\n", ev.Host)
+		case "runner_on_failed":
+			fmt.Fprintf(w, "failed: [%s]\n", ev.Host)
+		case "runner_on_unreachable":
+			fmt.Fprintf(w, "unreachable: [%s]\n", ev.Host)
+		case "runner_on_skipped":
+			fmt.Fprintf(w, "skipping: [%s]\n", ev.Host)
+		}
+	}
+	return it.Err()
+}
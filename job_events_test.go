@@ -0,0 +1,117 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// fakeRequester is a minimal Requester that serves job_events pages off an
+// httptest.Server, so List/Iterator.Next exercise the exact same GetJSON(url,
+// result, params) path the real Client.Requester does.
+type fakeRequester struct {
+	base string
+}
+
+func (f fakeRequester) do(endpoint string, params map[string]string) (*http.Response, error) {
+	url := endpoint
+	if !strings.HasPrefix(url, f.base) {
+		url = f.base + url
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	for k, v := range params {
+		q.Set(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	return http.DefaultClient.Do(req)
+}
+
+func (f fakeRequester) Get(endpoint string, params map[string]string) (*http.Response, error) {
+	return f.do(endpoint, params)
+}
+
+func (f fakeRequester) GetJSON(endpoint string, result interface{}, params map[string]string) (*http.Response, error) {
+	resp, err := f.do(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return resp, json.NewDecoder(resp.Body).Decode(result)
+}
+
+func (f fakeRequester) PostJSON(string, interface{}, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+func (f fakeRequester) PatchJSON(string, interface{}, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+func (f fakeRequester) Delete(string, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+
+// TestJobEventServiceListPagination checks that List, after a first request made
+// with params, follows the `page=2` cursor AWX embedded in `next`, fetching each
+// page exactly once and yielding every event in order.
+func TestJobEventServiceListPagination(t *testing.T) {
+	var page1Hits, page2Hits int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/jobs/1/job_events/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			page2Hits++
+			w.Write([]byte(`{"count":3,"next":null,"previous":"/api/v2/jobs/1/job_events/","results":[{"counter":3,"event":"runner_on_ok"}]}`))
+			return
+		}
+		page1Hits++
+		w.Write([]byte(`{"count":3,"next":"/api/v2/jobs/1/job_events/?page=2","previous":null,"results":[{"counter":1,"event":"playbook_on_start"},{"counter":2,"event":"playbook_on_play_start"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	svc := &JobEventService{client: &Client{Requester: fakeRequester{base: server.URL}}}
+	it := svc.List(1, map[string]string{"page_size": "2"})
+
+	var counters []int
+	ctx := context.Background()
+	for {
+		ev, ok := it.Next(ctx)
+		if !ok {
+			break
+		}
+		counters = append(counters, ev.Counter)
+		if len(counters) > 10 {
+			t.Fatal("pagination looped without terminating")
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !equalInts(counters, want) {
+		t.Fatalf("got counters %v, want %v (page 2 was never reached correctly)", counters, want)
+	}
+	if page1Hits != 1 {
+		t.Fatalf("page 1 fetched %d times, want exactly 1 (page collapsed back to page 1 repeatedly)", page1Hits)
+	}
+	if page2Hits != 1 {
+		t.Fatalf("page 2 fetched %d times, want exactly 1", page2Hits)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,7 @@
+package awx
+
+// JobTemplateService implements awx job template apis for plain (non-workflow)
+// job templates.
+type JobTemplateService struct {
+	client *Client
+}
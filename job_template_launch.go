@@ -0,0 +1,58 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// jobTemplateLaunchResponse represents the `/api/v2/job_templates/{id}/launch/`
+// response. Unexported: LaunchWithOptions only needs the launched job's ID, and
+// exporting a brand-new type here risks colliding with whatever the rest of the
+// package (job.go, not present in this change set) already uses to
+// represent a launch result.
+type jobTemplateLaunchResponse struct {
+	Job int `json:"job"`
+}
+
+// LaunchWithOptions launches the job template with opts, first fetching the
+// template's launch prompts (`GET .../launch/`) to validate opts against what it
+// actually allows, returning a *LaunchValidationError if opts asks for more (or
+// less) than the template permits. On success it returns the launched job's ID;
+// wait for completion separately via JobTemplateService.WaitForJob.
+func (jt *JobTemplateService) LaunchWithOptions(ctx context.Context, id int, opts LaunchOptions) (int, error) {
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/launch/", id)
+
+	prompts := new(launchPrompts)
+	resp, err := jt.client.Requester.GetJSON(endpoint, prompts, nil)
+	if err != nil {
+		return 0, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		return 0, err
+	}
+	if err := prompts.validate(opts); err != nil {
+		return 0, err
+	}
+
+	payload, err := json.Marshal(opts)
+	if err != nil {
+		return 0, err
+	}
+
+	result := new(jobTemplateLaunchResponse)
+	resp, err = jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	if err != nil {
+		return 0, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		return 0, err
+	}
+
+	if result.Job == 0 {
+		return 0, errors.New("invalid job id 0")
+	}
+	return result.Job, nil
+}
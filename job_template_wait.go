@@ -0,0 +1,30 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+)
+
+// WaitForJob waits for the job to leave PENDING/WAITING/RUNNING, ctx is canceled,
+// or opts runs out of tries/time, whichever happens first. If the client was built
+// with WithEventStream, it prefers the `/websocket/` transport and falls back to
+// HTTP polling if the subscription can't be established or drops mid-wait.
+func (jt *JobTemplateService) WaitForJob(ctx context.Context, jobID int, opts WaitOptions) (*Job, error) {
+	poll := func(id int) (*Job, error) {
+		result := &Job{}
+		endpoint := fmt.Sprintf("/api/v2/jobs/%d", id)
+		resp, err := jt.client.Requester.GetJSON(endpoint, result, nil)
+		if err != nil {
+			return result, err
+		}
+		if err := CheckResponse(resp); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+
+	if !jt.client.useEventStream {
+		return waitForJob(ctx, jobID, opts, poll)
+	}
+	return waitForJobViaEvents(ctx, jt.client, jobID, opts, poll)
+}
@@ -0,0 +1,80 @@
+package awx
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// fakeJobRequester serves a single job's `/api/v2/jobs/{id}` GetJSON without a real
+// server, just enough for WaitForJob's poll path.
+type fakeJobRequester struct {
+	status string
+	calls  int
+}
+
+func (f *fakeJobRequester) Get(string, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+
+func (f *fakeJobRequester) GetJSON(endpoint string, result interface{}, params map[string]string) (*http.Response, error) {
+	f.calls++
+	job, ok := result.(*Job)
+	if !ok {
+		return nil, nil
+	}
+	job.Status = f.status
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func (f *fakeJobRequester) PostJSON(string, interface{}, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+func (f *fakeJobRequester) PatchJSON(string, interface{}, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+func (f *fakeJobRequester) Delete(string, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+
+// TestJobTemplateServiceWaitForJobPollsByDefault confirms a client without
+// WithEventStream never touches the websocket transport.
+func TestJobTemplateServiceWaitForJobPollsByDefault(t *testing.T) {
+	requester := &fakeJobRequester{status: string(SUCCESSFUL)}
+	client := &Client{Requester: requester}
+	jt := &JobTemplateService{client: client}
+
+	job, err := jt.WaitForJob(context.Background(), 1, WaitOptions{})
+	if err != nil {
+		t.Fatalf("WaitForJob() error = %v", err)
+	}
+	if job.Status != string(SUCCESSFUL) {
+		t.Fatalf("job.Status = %q, want %q", job.Status, SUCCESSFUL)
+	}
+	if requester.calls != 1 {
+		t.Fatalf("GetJSON called %d times, want 1", requester.calls)
+	}
+}
+
+// TestJobTemplateServiceWaitForJobPrefersEventStream confirms that, mirroring
+// WorkflowJobTemplateService, a client built with WithEventStream routes plain job
+// templates through waitForJobViaEvents too (falling back to polling here since
+// nothing is listening on the websocket).
+func TestJobTemplateServiceWaitForJobPrefersEventStream(t *testing.T) {
+	requester := &fakeJobRequester{status: string(SUCCESSFUL)}
+	client := &Client{BaseURL: "http://127.0.0.1:0", Requester: requester}
+	client.Events = &EventStreamService{client: client}
+	WithEventStream()(client)
+	jt := &JobTemplateService{client: client}
+
+	job, err := jt.WaitForJob(context.Background(), 1, WaitOptions{InitialInterval: 1})
+	if err != nil {
+		t.Fatalf("WaitForJob() error = %v", err)
+	}
+	if job.Status != string(SUCCESSFUL) {
+		t.Fatalf("job.Status = %q, want %q", job.Status, SUCCESSFUL)
+	}
+	if requester.calls == 0 {
+		t.Fatal("GetJSON never called, want fallback to polling once Subscribe fails")
+	}
+}
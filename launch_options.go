@@ -0,0 +1,92 @@
+package awx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LaunchOptions overrides the prompts AWX asks for at launch time. A field is only
+// honored if the template's `ask_*_on_launch` flags allow overriding it; otherwise
+// LaunchWithOptions rejects the call with a LaunchValidationError.
+type LaunchOptions struct {
+	ExtraVars   map[string]interface{} `json:"extra_vars,omitempty"`
+	Inventory   int                    `json:"inventory,omitempty"`
+	Credentials []int                  `json:"credentials,omitempty"`
+	JobTags     string                 `json:"job_tags,omitempty"`
+	SkipTags    string                 `json:"skip_tags,omitempty"`
+	Limit       string                 `json:"limit,omitempty"`
+	ScmBranch   string                 `json:"scm_branch,omitempty"`
+	DiffMode    *bool                  `json:"diff_mode,omitempty"`
+	Verbosity   *int                   `json:"verbosity,omitempty"`
+}
+
+// LaunchValidationError reports LaunchOptions fields that the template requires but
+// didn't receive, or that it doesn't allow to be overridden at all.
+type LaunchValidationError struct {
+	Missing    []string
+	NotAllowed []string
+}
+
+func (e *LaunchValidationError) Error() string {
+	var parts []string
+	if len(e.Missing) > 0 {
+		parts = append(parts, fmt.Sprintf("missing required fields: %s", strings.Join(e.Missing, ", ")))
+	}
+	if len(e.NotAllowed) > 0 {
+		parts = append(parts, fmt.Sprintf("fields not allowed by this template: %s", strings.Join(e.NotAllowed, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// launchPrompts is the subset of a template's `GET .../launch/` response needed to
+// validate LaunchOptions against what that template actually allows.
+type launchPrompts struct {
+	AskVariablesOnLaunch   bool     `json:"ask_variables_on_launch"`
+	AskInventoryOnLaunch   bool     `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch  bool     `json:"ask_credential_on_launch"`
+	AskTagsOnLaunch        bool     `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch    bool     `json:"ask_skip_tags_on_launch"`
+	AskLimitOnLaunch       bool     `json:"ask_limit_on_launch"`
+	AskScmBranchOnLaunch   bool     `json:"ask_scm_branch_on_launch"`
+	AskDiffModeOnLaunch    bool     `json:"ask_diff_mode_on_launch"`
+	AskVerbosityOnLaunch   bool     `json:"ask_verbosity_on_launch"`
+	VariablesNeededToStart []string `json:"variables_needed_to_start"`
+}
+
+// validate checks opts against the prompts a template actually allows, returning a
+// *LaunchValidationError (nil if opts is acceptable as-is).
+func (p launchPrompts) validate(opts LaunchOptions) error {
+	verr := &LaunchValidationError{}
+
+	for _, name := range p.VariablesNeededToStart {
+		if _, ok := opts.ExtraVars[name]; !ok {
+			verr.Missing = append(verr.Missing, "extra_vars."+name)
+		}
+	}
+
+	checks := []struct {
+		set     bool
+		allowed bool
+		field   string
+	}{
+		{len(opts.ExtraVars) > 0, p.AskVariablesOnLaunch, "extra_vars"},
+		{opts.Inventory != 0, p.AskInventoryOnLaunch, "inventory"},
+		{len(opts.Credentials) > 0, p.AskCredentialOnLaunch, "credentials"},
+		{opts.JobTags != "", p.AskTagsOnLaunch, "job_tags"},
+		{opts.SkipTags != "", p.AskSkipTagsOnLaunch, "skip_tags"},
+		{opts.Limit != "", p.AskLimitOnLaunch, "limit"},
+		{opts.ScmBranch != "", p.AskScmBranchOnLaunch, "scm_branch"},
+		{opts.DiffMode != nil, p.AskDiffModeOnLaunch, "diff_mode"},
+		{opts.Verbosity != nil, p.AskVerbosityOnLaunch, "verbosity"},
+	}
+	for _, c := range checks {
+		if c.set && !c.allowed {
+			verr.NotAllowed = append(verr.NotAllowed, c.field)
+		}
+	}
+
+	if len(verr.Missing) == 0 && len(verr.NotAllowed) == 0 {
+		return nil
+	}
+	return verr
+}
@@ -0,0 +1,90 @@
+package awx
+
+import "testing"
+
+func TestLaunchPromptsValidate(t *testing.T) {
+	verbosity := 2
+
+	cases := []struct {
+		name       string
+		prompts    launchPrompts
+		opts       LaunchOptions
+		wantErr    bool
+		missing    []string
+		notAllowed []string
+	}{
+		{
+			name:    "nothing set, nothing required",
+			prompts: launchPrompts{},
+			opts:    LaunchOptions{},
+			wantErr: false,
+		},
+		{
+			name:    "required extra_vars missing",
+			prompts: launchPrompts{AskVariablesOnLaunch: true, VariablesNeededToStart: []string{"release"}},
+			opts:    LaunchOptions{},
+			wantErr: true,
+			missing: []string{"extra_vars.release"},
+		},
+		{
+			name:    "required extra_vars satisfied",
+			prompts: launchPrompts{AskVariablesOnLaunch: true, VariablesNeededToStart: []string{"release"}},
+			opts:    LaunchOptions{ExtraVars: map[string]interface{}{"release": "v1"}},
+			wantErr: false,
+		},
+		{
+			name:       "limit not allowed by template",
+			prompts:    launchPrompts{AskLimitOnLaunch: false},
+			opts:       LaunchOptions{Limit: "webservers"},
+			wantErr:    true,
+			notAllowed: []string{"limit"},
+		},
+		{
+			name:    "limit allowed",
+			prompts: launchPrompts{AskLimitOnLaunch: true},
+			opts:    LaunchOptions{Limit: "webservers"},
+			wantErr: false,
+		},
+		{
+			name:       "verbosity not allowed by template",
+			prompts:    launchPrompts{},
+			opts:       LaunchOptions{Verbosity: &verbosity},
+			wantErr:    true,
+			notAllowed: []string{"verbosity"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.prompts.validate(tc.opts)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("validate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			verr, ok := err.(*LaunchValidationError)
+			if !ok {
+				t.Fatalf("validate() error type = %T, want *LaunchValidationError", err)
+			}
+			if !equalStrings(verr.Missing, tc.missing) {
+				t.Errorf("Missing = %v, want %v", verr.Missing, tc.missing)
+			}
+			if !equalStrings(verr.NotAllowed, tc.notAllowed) {
+				t.Errorf("NotAllowed = %v, want %v", verr.NotAllowed, tc.notAllowed)
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package awx
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	awxerrors "github.com/xu001186/awx-go/errors"
+)
+
+// CheckResponse classifies resp's status code into a typed error from the errors
+// package, reading (and closing) resp.Body to populate the error's details. Every
+// service routes its *http.Response through this before touching the decoded
+// result, so callers can branch with errors.Is/errors.As instead of string-matching
+// on messages.
+func CheckResponse(resp *http.Response) error {
+	if resp == nil || (resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return nil
+	}
+
+	body := ""
+	if resp.Body != nil {
+		b, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		body = string(b)
+	}
+	apiErr := &awxerrors.APIError{Status: resp.StatusCode, Body: body, RequestID: resp.Header.Get("X-Request-Id")}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %s", awxerrors.ErrNotFound, apiErr)
+	case http.StatusConflict:
+		return fmt.Errorf("%w: %s", awxerrors.ErrConflict, apiErr)
+	default:
+		return apiErr
+	}
+}
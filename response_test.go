@@ -0,0 +1,70 @@
+package awx
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	awxerrors "github.com/xu001186/awx-go/errors"
+)
+
+func TestCheckResponse(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "ok", status: http.StatusOK, wantErr: nil},
+		{name: "not found", status: http.StatusNotFound, wantErr: awxerrors.ErrNotFound},
+		{name: "conflict", status: http.StatusConflict, wantErr: awxerrors.ErrConflict},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: tc.status,
+				Body:       io.NopCloser(strings.NewReader("boom")),
+				Header:     http.Header{"X-Request-Id": []string{"req-1"}},
+			}
+
+			err := CheckResponse(resp)
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Fatalf("CheckResponse() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("CheckResponse() error = %v, want wrapping %v", err, tc.wantErr)
+			}
+			if !strings.Contains(err.Error(), "boom") {
+				t.Fatalf("CheckResponse() error = %q, want it to include the response body", err.Error())
+			}
+		})
+	}
+}
+
+func TestCheckResponseDefaultIsPlainAPIError(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusInternalServerError,
+		Body:       io.NopCloser(strings.NewReader("kaboom")),
+		Header:     http.Header{},
+	}
+
+	err := CheckResponse(resp)
+	var apiErr *awxerrors.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("CheckResponse() error = %v, want *awxerrors.APIError", err)
+	}
+	if apiErr.Status != http.StatusInternalServerError {
+		t.Fatalf("apiErr.Status = %d, want %d", apiErr.Status, http.StatusInternalServerError)
+	}
+}
+
+func TestCheckResponseNil(t *testing.T) {
+	if err := CheckResponse(nil); err != nil {
+		t.Fatalf("CheckResponse(nil) error = %v, want nil", err)
+	}
+}
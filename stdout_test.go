@@ -0,0 +1,130 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeStdoutRequester serves a job's /stdout/ and status endpoints off an
+// httptest.Server, so fetchStdoutBody/StreamStdout exercise the exact same
+// Requester.Get/GetJSON path the real Requester does.
+type fakeStdoutRequester struct {
+	base string
+}
+
+func (f fakeStdoutRequester) do(endpoint string, params map[string]string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, f.base+endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	for k, v := range params {
+		q.Set(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+	return http.DefaultClient.Do(req)
+}
+
+func (f fakeStdoutRequester) Get(endpoint string, params map[string]string) (*http.Response, error) {
+	return f.do(endpoint, params)
+}
+
+func (f fakeStdoutRequester) GetJSON(endpoint string, result interface{}, params map[string]string) (*http.Response, error) {
+	resp, err := f.do(endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return resp, json.NewDecoder(resp.Body).Decode(result)
+}
+
+func (f fakeStdoutRequester) PostJSON(string, interface{}, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+func (f fakeStdoutRequester) PatchJSON(string, interface{}, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+func (f fakeStdoutRequester) Delete(string, interface{}, map[string]string) (*http.Response, error) {
+	return nil, nil
+}
+
+// TestFetchStdoutBodyDefaultFormatIsPlainText reproduces the regression where the
+// default format (txt_download) was decoded as the {"content": ...} JSON envelope
+// only format=json actually returns; every other format is a plain-text body.
+func TestFetchStdoutBodyDefaultFormatIsPlainText(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/jobs/1/stdout/", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("format"); got != string(StdoutFormatText) {
+			t.Errorf("format = %q, want %q", got, StdoutFormatText)
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte("PLAY [site] ****\nok: [web1]\n"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	params := map[string]string{"format": string(StdoutFormatText)}
+	body, resp, err := fetchStdoutBody(fakeStdoutRequester{base: server.URL}, "/api/v2/jobs/1/stdout/", params, StdoutFormatText)
+	if err != nil {
+		t.Fatalf("fetchStdoutBody() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if want := "PLAY [site] ****\nok: [web1]\n"; string(body) != want {
+		t.Fatalf("body = %q, want %q", body, want)
+	}
+}
+
+// TestFetchStdoutBodyJSONFormat covers the one format AWX really does wrap in a
+// {"content": ...} envelope.
+func TestFetchStdoutBodyJSONFormat(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/jobs/1/stdout/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"content":"ok: [web1]\n"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	params := map[string]string{"format": string(StdoutFormatJSON)}
+	body, _, err := fetchStdoutBody(fakeStdoutRequester{base: server.URL}, "/api/v2/jobs/1/stdout/", params, StdoutFormatJSON)
+	if err != nil {
+		t.Fatalf("fetchStdoutBody() error = %v", err)
+	}
+	if want := "ok: [web1]\n"; string(body) != want {
+		t.Fatalf("body = %q, want %q", body, want)
+	}
+}
+
+// TestWorkflowJobTemplateServiceStreamStdout exercises StreamStdout end to end with
+// its documented default StdoutOptions{}, which previously never yielded output.
+func TestWorkflowJobTemplateServiceStreamStdout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/jobs/1/stdout/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok: [web1]\n"))
+	})
+	mux.HandleFunc("/api/v2/jobs/1/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"successful"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	jt := &WorkflowJobTemplateService{client: &Client{Requester: fakeStdoutRequester{base: server.URL}}}
+	rc, err := jt.StreamStdout(context.Background(), 1, StdoutOptions{})
+	if err != nil {
+		t.Fatalf("StreamStdout() error = %v", err)
+	}
+	defer rc.Close()
+
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if want := "ok: [web1]\n"; string(got) != want {
+		t.Fatalf("stdout = %q, want %q", got, want)
+	}
+}
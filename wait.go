@@ -0,0 +1,99 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// WaitOptions configures the exponential-backoff polling performed by WaitForJob.
+type WaitOptions struct {
+	// InitialInterval is the delay between the first status check, which happens
+	// immediately, and the second. Defaults to 2s.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between checks. Defaults to 30s.
+	MaxInterval time.Duration
+	// Multiplier grows the delay after every check. Defaults to 2.
+	Multiplier float64
+	// Jitter adds up to this fraction of randomness to each delay, e.g. 0.1 for +/-10%.
+	Jitter float64
+	// MaxTries bounds the number of status checks. -1 (the default) means unlimited.
+	MaxTries int
+	// Timeout bounds the total time spent waiting. Zero means unlimited.
+	Timeout time.Duration
+	// OnStatus, if set, is called with the latest job after every status check.
+	OnStatus func(*Job)
+}
+
+func (o WaitOptions) withDefaults() WaitOptions {
+	if o.InitialInterval <= 0 {
+		o.InitialInterval = 2 * time.Second
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = 30 * time.Second
+	}
+	if o.Multiplier <= 0 {
+		o.Multiplier = 2
+	}
+	if o.MaxTries == 0 {
+		o.MaxTries = -1
+	}
+	return o
+}
+
+// jobFetcher retrieves the current state of a single job.
+type jobFetcher func(jobID int) (*Job, error)
+
+// isTerminal reports whether a job has left the pending/waiting/running states.
+func isTerminal(job *Job) bool {
+	return job.Status != string(PENDING) && job.Status != string(WAITING) && job.Status != string(RUNNING)
+}
+
+// waitForJob polls fetch with exponential backoff until the job reaches a terminal
+// status, ctx is canceled, or opts runs out of tries/time, whichever comes first.
+func waitForJob(ctx context.Context, jobID int, opts WaitOptions, fetch jobFetcher) (*Job, error) {
+	opts = opts.withDefaults()
+
+	var deadline <-chan time.Time
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	interval := opts.InitialInterval
+	for tryCount := 0; ; tryCount++ {
+		job, err := fetch(jobID)
+		if err != nil {
+			return job, err
+		}
+		if opts.OnStatus != nil {
+			opts.OnStatus(job)
+		}
+		if isTerminal(job) {
+			return job, nil
+		}
+		if opts.MaxTries != -1 && tryCount+1 >= opts.MaxTries {
+			return job, fmt.Errorf("the maximum number %v of checking job status has been reached", opts.MaxTries)
+		}
+
+		delay := interval
+		if opts.Jitter > 0 {
+			delay += time.Duration((rand.Float64()*2 - 1) * opts.Jitter * float64(delay))
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-deadline:
+			return job, fmt.Errorf("timed out after %v waiting for job %d to finish", opts.Timeout, jobID)
+		case <-time.After(delay):
+		}
+
+		interval = time.Duration(float64(interval) * opts.Multiplier)
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
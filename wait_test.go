@@ -0,0 +1,117 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForJobTerminalOnFirstCheck(t *testing.T) {
+	calls := 0
+	fetch := func(int) (*Job, error) {
+		calls++
+		return &Job{Status: string(SUCCESSFUL)}, nil
+	}
+
+	job, err := waitForJob(context.Background(), 1, WaitOptions{}, fetch)
+	if err != nil {
+		t.Fatalf("waitForJob() error = %v", err)
+	}
+	if job.Status != string(SUCCESSFUL) {
+		t.Fatalf("job.Status = %q, want %q", job.Status, SUCCESSFUL)
+	}
+	if calls != 1 {
+		t.Fatalf("fetch called %d times, want 1", calls)
+	}
+}
+
+func TestWaitForJobPollsUntilTerminal(t *testing.T) {
+	calls := 0
+	fetch := func(int) (*Job, error) {
+		calls++
+		if calls < 3 {
+			return &Job{Status: string(RUNNING)}, nil
+		}
+		return &Job{Status: string(SUCCESSFUL)}, nil
+	}
+
+	var statuses []string
+	opts := WaitOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+		OnStatus:        func(j *Job) { statuses = append(statuses, j.Status) },
+	}
+	job, err := waitForJob(context.Background(), 1, opts, fetch)
+	if err != nil {
+		t.Fatalf("waitForJob() error = %v", err)
+	}
+	if job.Status != string(SUCCESSFUL) {
+		t.Fatalf("job.Status = %q, want %q", job.Status, SUCCESSFUL)
+	}
+	if calls != 3 {
+		t.Fatalf("fetch called %d times, want 3", calls)
+	}
+	if len(statuses) != 3 {
+		t.Fatalf("OnStatus called %d times, want 3", len(statuses))
+	}
+}
+
+func TestWaitForJobMaxTriesExceeded(t *testing.T) {
+	fetch := func(int) (*Job, error) {
+		return &Job{Status: string(RUNNING)}, nil
+	}
+
+	opts := WaitOptions{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		MaxTries:        2,
+	}
+	_, err := waitForJob(context.Background(), 1, opts, fetch)
+	if err == nil {
+		t.Fatal("waitForJob() error = nil, want max-tries error")
+	}
+}
+
+func TestWaitForJobContextCanceled(t *testing.T) {
+	fetch := func(int) (*Job, error) {
+		return &Job{Status: string(RUNNING)}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	opts := WaitOptions{InitialInterval: time.Hour}
+	_, err := waitForJob(ctx, 1, opts, fetch)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("waitForJob() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestWaitForJobFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func(int) (*Job, error) {
+		return &Job{}, wantErr
+	}
+
+	_, err := waitForJob(context.Background(), 1, WaitOptions{}, fetch)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("waitForJob() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestWaitOptionsWithDefaults(t *testing.T) {
+	opts := WaitOptions{}.withDefaults()
+	if opts.InitialInterval != 2*time.Second {
+		t.Errorf("InitialInterval = %v, want 2s", opts.InitialInterval)
+	}
+	if opts.MaxInterval != 30*time.Second {
+		t.Errorf("MaxInterval = %v, want 30s", opts.MaxInterval)
+	}
+	if opts.Multiplier != 2 {
+		t.Errorf("Multiplier = %v, want 2", opts.Multiplier)
+	}
+	if opts.MaxTries != -1 {
+		t.Errorf("MaxTries = %v, want -1", opts.MaxTries)
+	}
+}
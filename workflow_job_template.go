@@ -2,10 +2,14 @@ package awx
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
+
+	awxerrors "github.com/xu001186/awx-go/errors"
 )
 
 // JobStatus the job status
@@ -64,14 +68,43 @@ func (jt *WorkflowJobTemplateService) GetWorkflowJobTemplateByName(name string)
 	}
 
 	if result.Count == 0 {
-		return nil, fmt.Errorf("The %v can't be found")
+		return nil, fmt.Errorf("%w: workflow job template %q", awxerrors.ErrNotFound, name)
 	}
 
 	return result.Results[0], nil
 }
 
+// WaitForJob waits for the workflow job to leave PENDING/WAITING/RUNNING, ctx is
+// canceled, or opts runs out of tries/time, whichever happens first. If the client
+// was built with WithEventStream, it prefers the `/websocket/` transport and falls
+// back to HTTP polling if the subscription can't be established or drops mid-wait.
+func (jt *WorkflowJobTemplateService) WaitForJob(ctx context.Context, jobID int, opts WaitOptions) (*Job, error) {
+	poll := func(id int) (*Job, error) {
+		result := &Job{}
+		endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d", id)
+		resp, err := jt.client.Requester.GetJSON(endpoint, result, nil)
+		if err != nil {
+			return result, err
+		}
+		if err := CheckResponse(resp); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+
+	if !jt.client.useEventStream {
+		return waitForJob(ctx, jobID, opts, poll)
+	}
+
+	job, err := waitForJobViaEvents(ctx, jt.client, jobID, opts, poll)
+	if err != nil {
+		return job, err
+	}
+	return job, nil
+}
+
 // Launch Workflow doesn't support the extra variables
-func (jt *WorkflowJobTemplateService) Launch(id int, jobHanlder JobHanlder, jobCheckInterval, jobCheckmaxtries int) (*WorkflowJobLaunch, *Job, error) {
+func (jt *WorkflowJobTemplateService) Launch(ctx context.Context, id int, jobHanlder JobHanlder, jobCheckInterval, jobCheckmaxtries int) (*WorkflowJobLaunch, *Job, error) {
 
 	result := new(WorkflowJobLaunch)
 	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/launch/", id)
@@ -88,69 +121,78 @@ func (jt *WorkflowJobTemplateService) Launch(id int, jobHanlder JobHanlder, jobC
 	if result.WorkflowJob == 0 {
 		return nil, nil, errors.New("invalid job id 0")
 	}
-	jobresult, err := jobHanlder(jt, result.WorkflowJob, jobCheckInterval, jobCheckmaxtries)
+	jobresult, err := jobHanlder(ctx, jt, result.WorkflowJob, jobCheckInterval, jobCheckmaxtries)
 	return result, jobresult, err
 }
 
-//JobHanlder handler the job
-type JobHanlder func(jt *WorkflowJobTemplateService, jobid, checkInterval, maxtries int) (*Job, error)
+// LaunchWithOptions launches the workflow job template with opts, first fetching the
+// template's launch prompts (`GET .../launch/`) to validate opts against what it
+// actually allows, returning a *LaunchValidationError if opts asks for more (or
+// less) than the template permits. On success it returns the launched workflow
+// job's ID; wait for completion separately via WorkflowJobTemplateService.WaitForJob,
+// the same shape JobTemplateService.LaunchWithOptions uses.
+func (jt *WorkflowJobTemplateService) LaunchWithOptions(ctx context.Context, id int, opts LaunchOptions) (int, error) {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/launch/", id)
+
+	prompts := new(launchPrompts)
+	resp, err := jt.client.Requester.GetJSON(endpoint, prompts, nil)
+	if err != nil {
+		return 0, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		return 0, err
+	}
+	if err := prompts.validate(opts); err != nil {
+		return 0, err
+	}
 
-//CheckOnce check the result once
-func CheckOnce(jt *WorkflowJobTemplateService, jobid, checkInterval, maxtries int) (*Job, error) {
-	result := &Job{}
-	endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d", jobid)
-	resp, err := jt.client.Requester.GetJSON(endpoint, result, nil)
+	payload, err := json.Marshal(opts)
 	if err != nil {
-		return result, err
+		return 0, err
 	}
 
+	result := new(WorkflowJobLaunch)
+	resp, err = jt.client.Requester.PostJSON(endpoint, bytes.NewReader(payload), result, nil)
+	if err != nil {
+		return 0, err
+	}
 	if err := CheckResponse(resp); err != nil {
-		return result, err
+		return 0, err
+	}
+
+	// in case invalid job id return
+	if result.WorkflowJob == 0 {
+		return 0, errors.New("invalid job id 0")
 	}
-	return result, err
+	return result.WorkflowJob, nil
+}
+
+//JobHanlder handler the job
+type JobHanlder func(ctx context.Context, jt *WorkflowJobTemplateService, jobid, checkInterval, maxtries int) (*Job, error)
+
+//CheckOnce fetches jobid's current status once, erroring out if it hasn't reached a
+//terminal status yet. checkInterval/maxtries are unused (kept so CheckOnce still
+//satisfies JobHanlder) - a single check never has a second try to space out.
+func CheckOnce(ctx context.Context, jt *WorkflowJobTemplateService, jobid, checkInterval, maxtries int) (*Job, error) {
+	return jt.WaitForJob(ctx, jobid, WaitOptions{MaxTries: 1})
 }
 
 //DefaultJobHandler wait the job util it's not PENDING,WAITING or RUNNING
-func DefaultJobHandler(jt *WorkflowJobTemplateService, jobid, checkInterval, maxtries int) (*Job, error) {
-	result := &Job{}
-	quitChan := make(chan error)
-	tryCount := 0
-	go func(quitChan chan error, tryCount int) {
-		for {
-			endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d", jobid)
-			resp, err := jt.client.Requester.GetJSON(endpoint, result, nil)
-			if err != nil {
-				quitChan <- err
-			}
-
-			if err := CheckResponse(resp); err != nil {
-				quitChan <- err
-				return
-			}
-			if result.Status != string(PENDING) && result.Status != string(WAITING) && result.Status != string(RUNNING) {
-				quitChan <- nil
-				return
-			}
-			tryCount++
-			if tryCount <= maxtries && maxtries != -1 {
-				quitChan <- fmt.Errorf("The maximum number %v of checking job status has been reached ", maxtries)
-				return
-			}
-			time.Sleep(time.Duration(checkInterval) * time.Second)
-		}
-	}(quitChan, tryCount)
-	err := <-quitChan
-	return result, err
+func DefaultJobHandler(ctx context.Context, jt *WorkflowJobTemplateService, jobid, checkInterval, maxtries int) (*Job, error) {
+	return jt.WaitForJob(ctx, jobid, WaitOptions{
+		InitialInterval: time.Duration(checkInterval) * time.Second,
+		MaxInterval:     time.Duration(checkInterval) * time.Second,
+		MaxTries:        maxtries,
+	})
 }
 
 // CreateJobTemplate creates a job template
 func (jt *WorkflowJobTemplateService) CreateJobTemplate(data map[string]interface{}, params map[string]string) (*JobTemplate, error) {
 	result := new(JobTemplate)
-	mandatoryFields = []string{"name", "job_type", "inventory", "project"}
+	mandatoryFields := []string{"name", "job_type", "inventory", "project"}
 	validate, status := ValidateParams(data, mandatoryFields)
 	if !status {
-		err := fmt.Errorf("Mandatory input arguments are absent: %s", validate)
-		return nil, err
+		return nil, &awxerrors.ValidationError{Field: strings.Join(validate, ", "), Reason: "required field(s) missing"}
 	}
 	endpoint := "/api/v2/workflow_job_templates/"
 	payload, err := json.Marshal(data)
@@ -0,0 +1,36 @@
+package awx
+
+import (
+	"context"
+	"testing"
+)
+
+// TestCheckOnceTerminalJob confirms CheckOnce shares WaitForJob's fetch path rather
+// than hand-rolling its own GetJSON+CheckResponse block, and succeeds in a single
+// call when the job is already terminal.
+func TestCheckOnceTerminalJob(t *testing.T) {
+	requester := &fakeJobRequester{status: string(SUCCESSFUL)}
+	jt := &WorkflowJobTemplateService{client: &Client{Requester: requester}}
+
+	job, err := CheckOnce(context.Background(), jt, 1, 0, 0)
+	if err != nil {
+		t.Fatalf("CheckOnce() error = %v", err)
+	}
+	if job.Status != string(SUCCESSFUL) {
+		t.Fatalf("job.Status = %q, want %q", job.Status, SUCCESSFUL)
+	}
+	if requester.calls != 1 {
+		t.Fatalf("GetJSON called %d times, want exactly 1", requester.calls)
+	}
+}
+
+// TestCheckOnceNonTerminalJob confirms a single check that finds the job still
+// running surfaces an error instead of silently reporting a non-final status.
+func TestCheckOnceNonTerminalJob(t *testing.T) {
+	requester := &fakeJobRequester{status: string(RUNNING)}
+	jt := &WorkflowJobTemplateService{client: &Client{Requester: requester}}
+
+	if _, err := CheckOnce(context.Background(), jt, 1, 0, 0); err == nil {
+		t.Fatal("CheckOnce() error = nil for a still-running job, want error")
+	}
+}